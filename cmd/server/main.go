@@ -0,0 +1,81 @@
+// Command server runs the veramoney_apply HTTP server.
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/bryan-duarte/veramoney_apply/internal/buildinfo"
+	"github.com/bryan-duarte/veramoney_apply/internal/platform"
+	"github.com/bryan-duarte/veramoney_apply/internal/security"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	id, err := security.ResolveIdentity()
+	if err != nil {
+		return err
+	}
+
+	if os.Geteuid() == 0 {
+		if err := security.Drop(id); err != nil {
+			return err
+		}
+	}
+
+	if err := security.CheckNotRoot(); err != nil {
+		return err
+	}
+
+	log.Printf("running as uid=%d gid=%d", id.UID, id.GID)
+
+	info := buildinfo.Current()
+	log.Printf("build version=%s commit=%s date=%s go=%s", info.Version, info.Commit, info.BuildDate, info.GoVersion)
+
+	plat := platform.Current()
+	log.Printf("platform os=%s arch=%s numCpu=%d", plat.OS, plat.Arch, plat.NumCPU)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/whoami", whoamiHandler)
+	mux.HandleFunc("/version", versionHandler(info, plat))
+
+	log.Print("listening on :8080")
+	return http.ListenAndServe(":8080", versionHeaderMiddleware(info, mux))
+}
+
+// whoamiHandler reports the process's live uid/gid, not the configured
+// SERVER_RUN_AS_UID/GID, so it actually catches a drop that failed or
+// didn't happen.
+func whoamiHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		UID int `json:"uid"`
+		GID int `json:"gid"`
+	}{UID: os.Getuid(), GID: os.Getgid()})
+}
+
+func versionHandler(info buildinfo.Info, plat platform.Info) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(struct {
+			buildinfo.Info
+			Platform platform.Info `json:"platform"`
+		}{Info: info, Platform: plat})
+	}
+}
+
+// versionHeaderMiddleware stamps every response with the build version,
+// so operators can tell which build served a given request in production.
+func versionHeaderMiddleware(info buildinfo.Info, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Server-Version", info.Version)
+		next.ServeHTTP(w, r)
+	})
+}
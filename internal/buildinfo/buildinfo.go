@@ -0,0 +1,31 @@
+// Package buildinfo holds version metadata injected at link time via
+// -ldflags, so a running binary can report exactly what it was built from.
+package buildinfo
+
+// These are overridden at build time with:
+//
+//	go build -ldflags "-X .../buildinfo.Version=... -X .../buildinfo.Commit=... -X .../buildinfo.BuildDate=... -X .../buildinfo.GoVersion=..."
+var (
+	Version   = "dev"
+	Commit    = "unknown"
+	BuildDate = "unknown"
+	GoVersion = "unknown"
+)
+
+// Info is the JSON-serializable view of the build metadata.
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"buildDate"`
+	GoVersion string `json:"goVersion"`
+}
+
+// Current returns the build metadata for the running binary.
+func Current() Info {
+	return Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildDate: BuildDate,
+		GoVersion: GoVersion,
+	}
+}
@@ -0,0 +1,11 @@
+package buildinfo
+
+import "testing"
+
+func TestCurrent(t *testing.T) {
+	got := Current()
+	want := Info{Version: Version, Commit: Commit, BuildDate: BuildDate, GoVersion: GoVersion}
+	if got != want {
+		t.Fatalf("Current() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,21 @@
+// Package platform reports the OS/architecture the running binary was
+// compiled for, so a multi-arch image can be identified at runtime.
+package platform
+
+import "runtime"
+
+// Info describes the compiled-in target platform and host resources.
+type Info struct {
+	OS     string `json:"os"`
+	Arch   string `json:"arch"`
+	NumCPU int    `json:"numCpu"`
+}
+
+// Current returns the platform the running binary was built for.
+func Current() Info {
+	return Info{
+		OS:     runtime.GOOS,
+		Arch:   runtime.GOARCH,
+		NumCPU: runtime.NumCPU(),
+	}
+}
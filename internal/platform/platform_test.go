@@ -0,0 +1,19 @@
+package platform
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestCurrent(t *testing.T) {
+	got := Current()
+	if got.OS != runtime.GOOS {
+		t.Errorf("OS = %q, want %q", got.OS, runtime.GOOS)
+	}
+	if got.Arch != runtime.GOARCH {
+		t.Errorf("Arch = %q, want %q", got.Arch, runtime.GOARCH)
+	}
+	if got.NumCPU <= 0 {
+		t.Errorf("NumCPU = %d, want > 0", got.NumCPU)
+	}
+}
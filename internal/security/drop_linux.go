@@ -0,0 +1,16 @@
+//go:build linux
+
+package security
+
+import "syscall"
+
+// Drop switches the process to the given uid/gid. It must be called while
+// still running as root, before any untrusted input is processed. Group is
+// dropped before user, since dropping the uid first would remove the
+// privilege needed to change the gid.
+func Drop(id Identity) error {
+	if err := syscall.Setgid(id.GID); err != nil {
+		return err
+	}
+	return syscall.Setuid(id.UID)
+}
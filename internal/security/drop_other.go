@@ -0,0 +1,11 @@
+//go:build !linux
+
+package security
+
+import "fmt"
+
+// Drop is only supported on linux, the only platform this server ships a
+// container image for.
+func Drop(id Identity) error {
+	return fmt.Errorf("security: privilege drop is not supported on this platform")
+}
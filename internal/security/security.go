@@ -0,0 +1,52 @@
+// Package security resolves and enforces the unprivileged identity the
+// server should run as inside a container, so a misconfigured deployment
+// fails loudly instead of silently serving traffic as root.
+package security
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Identity is the uid/gid pair the server should end up running as.
+type Identity struct {
+	UID int
+	GID int
+}
+
+// ResolveIdentity reads SERVER_RUN_AS_UID and SERVER_RUN_AS_GID from the
+// environment. Either may be omitted, in which case the process's current
+// effective uid/gid is used instead.
+func ResolveIdentity() (Identity, error) {
+	id := Identity{UID: os.Geteuid(), GID: os.Getegid()}
+
+	if v, ok := os.LookupEnv("SERVER_RUN_AS_UID"); ok {
+		uid, err := strconv.Atoi(v)
+		if err != nil {
+			return Identity{}, fmt.Errorf("security: invalid SERVER_RUN_AS_UID %q: %w", v, err)
+		}
+		id.UID = uid
+	}
+	if v, ok := os.LookupEnv("SERVER_RUN_AS_GID"); ok {
+		gid, err := strconv.Atoi(v)
+		if err != nil {
+			return Identity{}, fmt.Errorf("security: invalid SERVER_RUN_AS_GID %q: %w", v, err)
+		}
+		id.GID = gid
+	}
+
+	return id, nil
+}
+
+// CheckNotRoot returns an error if the process is effectively running as
+// root, unless the operator has explicitly opted in via ALLOW_ROOT=1.
+func CheckNotRoot() error {
+	if os.Geteuid() != 0 {
+		return nil
+	}
+	if os.Getenv("ALLOW_ROOT") == "1" {
+		return nil
+	}
+	return fmt.Errorf("security: refusing to serve traffic as root (euid=0); set ALLOW_ROOT=1 to override")
+}
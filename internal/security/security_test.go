@@ -0,0 +1,52 @@
+package security
+
+import (
+	"os"
+	"testing"
+)
+
+func TestResolveIdentity(t *testing.T) {
+	t.Run("defaults to current euid/egid", func(t *testing.T) {
+		os.Unsetenv("SERVER_RUN_AS_UID")
+		os.Unsetenv("SERVER_RUN_AS_GID")
+
+		id, err := ResolveIdentity()
+		if err != nil {
+			t.Fatalf("ResolveIdentity() error = %v", err)
+		}
+		if id.UID != os.Geteuid() || id.GID != os.Getegid() {
+			t.Fatalf("ResolveIdentity() = %+v, want uid=%d gid=%d", id, os.Geteuid(), os.Getegid())
+		}
+	})
+
+	t.Run("reads env overrides", func(t *testing.T) {
+		t.Setenv("SERVER_RUN_AS_UID", "1000")
+		t.Setenv("SERVER_RUN_AS_GID", "1000")
+
+		id, err := ResolveIdentity()
+		if err != nil {
+			t.Fatalf("ResolveIdentity() error = %v", err)
+		}
+		if id.UID != 1000 || id.GID != 1000 {
+			t.Fatalf("ResolveIdentity() = %+v, want uid=1000 gid=1000", id)
+		}
+	})
+
+	t.Run("rejects invalid uid", func(t *testing.T) {
+		t.Setenv("SERVER_RUN_AS_UID", "not-a-number")
+
+		if _, err := ResolveIdentity(); err == nil {
+			t.Fatal("ResolveIdentity() error = nil, want error for invalid SERVER_RUN_AS_UID")
+		}
+	})
+}
+
+func TestCheckNotRoot(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("test process is running as root; CheckNotRoot() behavior depends on ALLOW_ROOT here")
+	}
+
+	if err := CheckNotRoot(); err != nil {
+		t.Fatalf("CheckNotRoot() error = %v, want nil for non-root euid", err)
+	}
+}